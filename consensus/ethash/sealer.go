@@ -22,6 +22,7 @@ import (
 	crand "crypto/rand"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math"
 	"math/big"
 	"math/rand"
@@ -186,8 +187,18 @@ search:
 	runtime.KeepAlive(dataset)
 }
 
-// This is the timeout for HTTP requests to notify external miners.
-const remoteSealerTimeout = 1 * time.Second
+const (
+	// This is the timeout for HTTP requests to notify external miners.
+	remoteSealerTimeout = 1 * time.Second
+
+	// remoteSealerRetries is the maximum number of times a work notification is
+	// resent to a single miner after a transient failure.
+	remoteSealerRetries = 3
+
+	// remoteSealerRetryDelay is the initial backoff between notification retries,
+	// doubled after every failed attempt.
+	remoteSealerRetryDelay = 100 * time.Millisecond
+)
 
 type remoteSealer struct {
 	works        map[common.Hash]*types.Block
@@ -196,6 +207,7 @@ type remoteSealer struct {
 	currentWork  [4]string
 	notifyCtx    context.Context
 	cancelNotify context.CancelFunc // cancels all notification requests
+	cancelWork   context.CancelFunc // cancels retries of the current work package
 	reqWG        sync.WaitGroup     // tracks notification request goroutines
 
 	ethash       *Ethash
@@ -357,34 +369,87 @@ func (s *remoteSealer) makeWork(block *types.Block) {
 // notifyWork notifies all the specified mining endpoints of the availability of
 // new work to be processed.
 func (s *remoteSealer) notifyWork() {
+	// Stop retrying the previous package, it's superseded by the new one
+	if s.cancelWork != nil {
+		s.cancelWork()
+	}
+	var ctx context.Context
+	ctx, s.cancelWork = context.WithCancel(s.notifyCtx)
+
 	work := s.currentWork
 	blob, _ := json.Marshal(work)
 	s.reqWG.Add(len(s.notifyURLs))
 	for _, url := range s.notifyURLs {
-		go s.sendNotification(s.notifyCtx, url, blob, work)
+		go s.sendNotification(ctx, url, blob, work)
 	}
 }
 
+// sendNotification delivers a work package to a single remote miner, retrying
+// transient failures until ctx is cancelled. The first attempt is only bound to
+// the sealer's lifetime, retries are aborted as soon as newer work is available
+// so a stale package never reaches the miner after its replacement.
 func (s *remoteSealer) sendNotification(ctx context.Context, url string, json []byte, work [4]string) {
 	defer s.reqWG.Done()
 
+	delay := remoteSealerRetryDelay
+	for attempt := 0; ; attempt++ {
+		reqCtx := s.notifyCtx
+		if attempt > 0 {
+			reqCtx = ctx
+		}
+		retry, err := s.postNotification(reqCtx, url, json)
+		if err == nil {
+			s.ethash.config.Log.Trace("Notified remote miner", "miner", url, "hash", work[0], "target", work[2])
+			return
+		}
+		if attempt > 0 && ctx.Err() != nil {
+			s.ethash.config.Log.Trace("Dropped superseded miner notification", "miner", url, "hash", work[0])
+			return
+		}
+		if !retry || attempt >= remoteSealerRetries {
+			s.ethash.config.Log.Warn("Failed to notify remote miner", "miner", url, "attempts", attempt+1, "err", err)
+			return
+		}
+		s.ethash.config.Log.Debug("Retrying remote miner notification", "miner", url, "attempt", attempt+1, "err", err)
+
+		// Back off before retrying, bailing out if the work is superseded or the
+		// sealer is shutting down
+		select {
+		case <-time.After(delay):
+			delay *= 2
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// postNotification sends a single work notification to a remote miner. Next to
+// the outcome it reports whether a failure is transient and worth retrying:
+// transport errors (connection refused, timeout) and server errors are, client
+// errors (4xx) and a cancelled notification context are not.
+func (s *remoteSealer) postNotification(ctx context.Context, url string, json []byte) (bool, error) {
 	req, err := http.NewRequest("POST", url, bytes.NewReader(json))
 	if err != nil {
-		s.ethash.config.Log.Warn("Can't create remote miner notification", "err", err)
-		return
+		return false, err
 	}
-	ctx, cancel := context.WithTimeout(ctx, remoteSealerTimeout)
+	reqCtx, cancel := context.WithTimeout(ctx, remoteSealerTimeout)
 	defer cancel()
-	req = req.WithContext(ctx)
+	req = req.WithContext(reqCtx)
 	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		s.ethash.config.Log.Warn("Failed to notify remote miner", "err", err)
-	} else {
-		s.ethash.config.Log.Trace("Notified remote miner", "miner", url, "hash", work[0], "target", work[2])
-		resp.Body.Close()
+		return ctx.Err() == nil, err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return true, fmt.Errorf("remote miner returned %s", resp.Status)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return false, fmt.Errorf("remote miner returned %s", resp.Status)
 	}
+	return false, nil
 }
 
 // submitWork verifies the submitted pow solution, returning
@@ -22,6 +22,7 @@ import (
 	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -119,6 +120,199 @@ func TestRemoteMultiNotify(t *testing.T) {
 	}
 }
 
+// Tests that a work notification failing with a transient error is retried and
+// eventually delivered to the remote miner.
+func TestRemoteNotifyRetry(t *testing.T) {
+	// Start a web server that drops the first connection and accepts afterwards.
+	var attempts int32
+	sink := make(chan [3]string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Errorf("failed to hijack connection: %v", err)
+				return
+			}
+			conn.Close()
+			return
+		}
+		blob, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			t.Errorf("failed to read miner notification: %v", err)
+		}
+		var work [3]string
+		if err := json.Unmarshal(blob, &work); err != nil {
+			t.Errorf("failed to unmarshal miner notification: %v", err)
+		}
+		sink <- work
+	}))
+	defer server.Close()
+
+	// Create the custom ethash engine.
+	ethash := NewTester([]string{server.URL}, false)
+	defer ethash.Close()
+
+	// Stream a work task and ensure the notification bubbles out after the retry.
+	header := &types.Header{Number: big.NewInt(1), Difficulty: big.NewInt(100)}
+	block := types.NewBlockWithHeader(header)
+
+	ethash.Seal(nil, block, nil, nil)
+	select {
+	case work := <-sink:
+		if want := ethash.SealHash(header).Hex(); work[0] != want {
+			t.Errorf("work packet hash mismatch: have %s, want %s", work[0], want)
+		}
+		if n := atomic.LoadInt32(&attempts); n != 2 {
+			t.Errorf("notification attempts mismatch: have %d, want %d", n, 2)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatalf("notification timed out")
+	}
+}
+
+// Tests that a work notification rejected by the remote miner with a server
+// error is retried and eventually delivered.
+func TestRemoteNotifyRetryOnServerError(t *testing.T) {
+	// Start a web server that is unavailable once and accepts afterwards.
+	var attempts int32
+	sink := make(chan [3]string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		blob, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			t.Errorf("failed to read miner notification: %v", err)
+		}
+		var work [3]string
+		if err := json.Unmarshal(blob, &work); err != nil {
+			t.Errorf("failed to unmarshal miner notification: %v", err)
+		}
+		sink <- work
+	}))
+	defer server.Close()
+
+	// Create the custom ethash engine.
+	ethash := NewTester([]string{server.URL}, false)
+	defer ethash.Close()
+
+	// Stream a work task and ensure the notification bubbles out after the retry.
+	header := &types.Header{Number: big.NewInt(1), Difficulty: big.NewInt(100)}
+	block := types.NewBlockWithHeader(header)
+
+	ethash.Seal(nil, block, nil, nil)
+	select {
+	case work := <-sink:
+		if want := ethash.SealHash(header).Hex(); work[0] != want {
+			t.Errorf("work packet hash mismatch: have %s, want %s", work[0], want)
+		}
+		if n := atomic.LoadInt32(&attempts); n != 2 {
+			t.Errorf("notification attempts mismatch: have %d, want %d", n, 2)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatalf("notification timed out")
+	}
+}
+
+// Tests that pending retries of a work notification are abandoned once newer
+// work is pushed, so a stale package never reaches the miner after its successor.
+func TestRemoteNotifyRetrySuperseded(t *testing.T) {
+	// Start a web server that drops the first connection and accepts afterwards.
+	var attempts int32
+	sink := make(chan [3]string, 16)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Errorf("failed to hijack connection: %v", err)
+				return
+			}
+			conn.Close()
+			return
+		}
+		blob, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			t.Errorf("failed to read miner notification: %v", err)
+		}
+		var work [3]string
+		if err := json.Unmarshal(blob, &work); err != nil {
+			t.Errorf("failed to unmarshal miner notification: %v", err)
+		}
+		sink <- work
+	}))
+	defer server.Close()
+
+	// Create the custom ethash engine.
+	ethash := NewTester([]string{server.URL}, false)
+	defer ethash.Close()
+
+	// Push two work packages back to back, the first one failing once.
+	headerA := &types.Header{Number: big.NewInt(1), Difficulty: big.NewInt(100)}
+	headerB := &types.Header{Number: big.NewInt(2), Difficulty: big.NewInt(100)}
+	hashA, hashB := ethash.SealHash(headerA).Hex(), ethash.SealHash(headerB).Hex()
+
+	ethash.Seal(nil, types.NewBlockWithHeader(headerA), nil, nil)
+	ethash.Seal(nil, types.NewBlockWithHeader(headerB), nil, nil)
+
+	// Wait for the newer package to be delivered.
+	timeout := time.After(3 * time.Second)
+	for delivered := false; !delivered; {
+		select {
+		case work := <-sink:
+			delivered = work[0] == hashB
+		case <-timeout:
+			t.Fatalf("notification timed out")
+		}
+	}
+	// Ensure the superseded package doesn't arrive afterwards, allowing enough
+	// time for all of its retries to have been attempted.
+	window := time.After(8 * remoteSealerRetryDelay)
+	for {
+		select {
+		case work := <-sink:
+			if work[0] == hashA {
+				t.Fatalf("superseded work package delivered after its replacement")
+			}
+		case <-window:
+			return
+		}
+	}
+}
+
+// Tests that a work notification rejected by the remote miner with a client
+// error is not retried.
+func TestRemoteNotifyNoRetryOnClientError(t *testing.T) {
+	// Start a web server that rejects every notification.
+	sink := make(chan struct{}, 16)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		sink <- struct{}{}
+	}))
+	defer server.Close()
+
+	// Create the custom ethash engine.
+	ethash := NewTester([]string{server.URL}, false)
+	defer ethash.Close()
+
+	header := &types.Header{Number: big.NewInt(1), Difficulty: big.NewInt(100)}
+	block := types.NewBlockWithHeader(header)
+	ethash.Seal(nil, block, nil, nil)
+
+	// Wait for the first notification, then ensure no retry follows it within
+	// the time all retries would have taken.
+	select {
+	case <-sink:
+	case <-time.After(3 * time.Second):
+		t.Fatalf("notification timed out")
+	}
+	select {
+	case <-sink:
+		t.Fatalf("notification rejected with client error was retried")
+	case <-time.After(8 * remoteSealerRetryDelay):
+	}
+}
+
 // Tests whether stale solutions are correctly processed.
 func TestStaleSubmission(t *testing.T) {
 	ethash := NewTester(nil, true)
@@ -335,6 +335,17 @@ func GenesisBlockForTesting(db ethdb.Database, addr common.Address, balance *big
 	return g.MustCommit(db)
 }
 
+// FundAccounts credits each of the given addresses in alloc with the specified
+// wei balance. Accounts already present in the alloc keep their code, storage
+// and nonce, only their balance is overwritten.
+func FundAccounts(alloc GenesisAlloc, addrs []common.Address, balance *big.Int) {
+	for _, addr := range addrs {
+		account := alloc[addr]
+		account.Balance = new(big.Int).Set(balance)
+		alloc[addr] = account
+	}
+}
+
 // DefaultGenesisBlock returns the Ethereum main net genesis block.
 func DefaultGenesisBlock() *Genesis {
 	return &Genesis{
@@ -162,3 +162,36 @@ func TestSetupGenesis(t *testing.T) {
 		}
 	}
 }
+
+func TestFundAccounts(t *testing.T) {
+	var (
+		code    = []byte{0x60, 0x00}
+		addrs   = []common.Address{{0x01}, {0x02}, {0x03}}
+		balance = big.NewInt(1000000)
+		alloc   = GenesisAlloc{
+			addrs[0]: {Code: code, Balance: big.NewInt(1)},
+		}
+	)
+	FundAccounts(alloc, addrs, balance)
+
+	if len(alloc) != len(addrs) {
+		t.Fatalf("alloc size mismatch: have %d, want %d", len(alloc), len(addrs))
+	}
+	for _, addr := range addrs {
+		account, ok := alloc[addr]
+		if !ok {
+			t.Fatalf("account %x not funded", addr)
+		}
+		if account.Balance.Cmp(balance) != 0 {
+			t.Errorf("account %x balance mismatch: have %v, want %v", addr, account.Balance, balance)
+		}
+	}
+	if !reflect.DeepEqual(alloc[addrs[0]].Code, code) {
+		t.Errorf("existing account code lost: have %x, want %x", alloc[addrs[0]].Code, code)
+	}
+	// Modifying the source balance must not leak into the alloc
+	balance.SetUint64(0)
+	if alloc[addrs[1]].Balance.Sign() == 0 {
+		t.Errorf("alloc balance aliases the source value")
+	}
+}
@@ -139,12 +139,12 @@ func makeGenesis(faucets []*ecdsa.PrivateKey, sealers []*ecdsa.PrivateKey) *core
 	genesis.Config.Clique.Period = 1
 	genesis.Config.EIP150Hash = common.Hash{}
 
-	genesis.Alloc = core.GenesisAlloc{}
-	for _, faucet := range faucets {
-		genesis.Alloc[crypto.PubkeyToAddress(faucet.PublicKey)] = core.GenesisAccount{
-			Balance: new(big.Int).Exp(big.NewInt(2), big.NewInt(128), nil),
-		}
+	addrs := make([]common.Address, len(faucets))
+	for i, faucet := range faucets {
+		addrs[i] = crypto.PubkeyToAddress(faucet.PublicKey)
 	}
+	genesis.Alloc = core.GenesisAlloc{}
+	core.FundAccounts(genesis.Alloc, addrs, new(big.Int).Exp(big.NewInt(2), big.NewInt(128), nil))
 	// Sort the signers and embed into the extra-data section
 	signers := make([]common.Address, len(sealers))
 	for i, sealer := range sealers {
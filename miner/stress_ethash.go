@@ -133,12 +133,12 @@ func makeGenesis(faucets []*ecdsa.PrivateKey) *core.Genesis {
 	genesis.Config.ChainID = big.NewInt(18)
 	genesis.Config.EIP150Hash = common.Hash{}
 
-	genesis.Alloc = core.GenesisAlloc{}
-	for _, faucet := range faucets {
-		genesis.Alloc[crypto.PubkeyToAddress(faucet.PublicKey)] = core.GenesisAccount{
-			Balance: new(big.Int).Exp(big.NewInt(2), big.NewInt(128), nil),
-		}
+	addrs := make([]common.Address, len(faucets))
+	for i, faucet := range faucets {
+		addrs[i] = crypto.PubkeyToAddress(faucet.PublicKey)
 	}
+	genesis.Alloc = core.GenesisAlloc{}
+	core.FundAccounts(genesis.Alloc, addrs, new(big.Int).Exp(big.NewInt(2), big.NewInt(128), nil))
 	return genesis
 }
 